@@ -0,0 +1,140 @@
+package build
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// Decoder parses an HCL file at path into a Plan. Most callers can just
+// pass nil to Load and get DefaultDecoder, backed by hclsimple; a custom
+// Decoder is only needed to support HCL extensions DefaultDecoder doesn't
+// know about.
+type Decoder func(path string) (*Plan, error)
+
+// Load reads and decodes the builds.hcl file at path using decode, or
+// DefaultDecoder if decode is nil.
+func Load(path string, decode Decoder) (*Plan, error) {
+	if decode == nil {
+		decode = DefaultDecoder
+	}
+	plan, err := decode(path)
+	if err != nil {
+		return nil, fmt.Errorf("build: decoding %s: %w", path, err)
+	}
+	return plan, nil
+}
+
+// DefaultDecoder parses an HCL file using the `masterflow { ... }`,
+// `build "<kind>" "<name>" { ... }`, and `task "<kind>" "<name>" { ... }`
+// schema documented on Plan.
+func DefaultDecoder(path string) (*Plan, error) {
+	var raw rawFile
+	if err := hclsimple.DecodeFile(path, evalCtx, &raw); err != nil {
+		return nil, err
+	}
+	return raw.plan(), nil
+}
+
+// evalCtx supplies the `toset(...)` function a `for_each` matrix block
+// uses; HCL has no such builtin, so builds.hcl gets a minimal one that
+// coerces its argument to a set of strings.
+var evalCtx = &hcl.EvalContext{
+	Functions: map[string]function.Function{"toset": toSetFunc},
+}
+
+var toSetFunc = function.New(&function.Spec{
+	Params: []function.Parameter{{Name: "list", Type: cty.DynamicPseudoType, AllowNull: false}},
+	Type: func(args []cty.Value) (cty.Type, error) {
+		return cty.Set(cty.String), nil
+	},
+	Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+		items := args[0].AsValueSlice()
+		if len(items) == 0 {
+			return cty.SetValEmpty(cty.String), nil
+		}
+		strs := make([]cty.Value, len(items))
+		for i, v := range items {
+			sv, err := convert.Convert(v, cty.String)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			strs[i] = sv
+		}
+		return cty.SetVal(strs), nil
+	},
+})
+
+type rawSettings struct {
+	ToolsDir string `hcl:"tools_dir"`
+	OutDir   string `hcl:"out_dir"`
+	LogsDir  string `hcl:"logs_dir"`
+}
+
+type rawBuild struct {
+	Kind      string            `hcl:"kind,label"`
+	Name      string            `hcl:"name,label"`
+	Binary    string            `hcl:"binary,optional"`
+	Main      string            `hcl:"main,optional"`
+	GOOS      string            `hcl:"goos,optional"`
+	GOARCH    string            `hcl:"goarch,optional"`
+	Flags     []string          `hcl:"flags,optional"`
+	LDFlags   []string          `hcl:"ldflags,optional"`
+	Env       map[string]string `hcl:"env,optional"`
+	DependsOn []string          `hcl:"depends_on,optional"`
+	ForEach   []string          `hcl:"for_each,optional"`
+}
+
+type rawTask struct {
+	Kind         string            `hcl:"kind,label"`
+	Name         string            `hcl:"name,label"`
+	Commands     []string          `hcl:"commands,optional"`
+	DependsOn    []string          `hcl:"depends_on,optional"`
+	InputMapping map[string]string `hcl:"input_mapping,optional"`
+}
+
+type rawFile struct {
+	Settings *rawSettings `hcl:"masterflow,block"`
+	Builds   []rawBuild   `hcl:"build,block"`
+	Tasks    []rawTask    `hcl:"task,block"`
+}
+
+func (r *rawFile) plan() *Plan {
+	p := &Plan{}
+	if r.Settings != nil {
+		p.Settings = Settings{
+			ToolsDir: r.Settings.ToolsDir,
+			OutDir:   r.Settings.OutDir,
+			LogsDir:  r.Settings.LogsDir,
+		}
+	}
+	for _, b := range r.Builds {
+		p.Builds = append(p.Builds, BuildSpec{
+			Kind:      b.Kind,
+			Name:      b.Name,
+			Binary:    b.Binary,
+			Main:      b.Main,
+			GOOS:      b.GOOS,
+			GOARCH:    b.GOARCH,
+			Flags:     b.Flags,
+			LDFlags:   b.LDFlags,
+			Env:       b.Env,
+			DependsOn: b.DependsOn,
+			ForEach:   b.ForEach,
+		})
+	}
+	for _, t := range r.Tasks {
+		p.Tasks = append(p.Tasks, TaskSpec{
+			Kind:         t.Kind,
+			Name:         t.Name,
+			Commands:     t.Commands,
+			DependsOn:    t.DependsOn,
+			InputMapping: t.InputMapping,
+		})
+	}
+	return p
+}
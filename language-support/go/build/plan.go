@@ -0,0 +1,147 @@
+/*
+Package build implements the MASTER-WORKFLOW declarative build/task DSL: an
+HCL file (conventionally .masterflow/builds.hcl) describing `build` and
+`task` blocks plus a top-level `masterflow` settings block. Loading it
+produces a Plan that the Runner can execute, and that ApplyBuildPlan (in
+languages/golang) uses to derive LanguageConfig.BuildTools.BuildCommand
+instead of hard-coding "go build".
+*/
+package build
+
+import "fmt"
+
+// Settings is the top-level `masterflow { ... }` block.
+type Settings struct {
+	ToolsDir string
+	OutDir   string
+	LogsDir  string
+}
+
+// BuildSpec is one `build "<kind>" "<name>" { ... }` block, e.g.
+// `build "go_build" "server"`.
+type BuildSpec struct {
+	Kind      string
+	Name      string
+	Binary    string
+	Main      string
+	GOOS      string
+	GOARCH    string
+	Flags     []string
+	LDFlags   []string
+	Env       map[string]string
+	DependsOn []string
+	// ForEach holds the matrix values for a `for_each = toset([...])` block;
+	// the runner fans this spec out into one instance per value.
+	ForEach []string
+}
+
+// TaskSpec is one `task "<kind>" "<name>" { ... }` block, e.g.
+// `task "script" "lint"`.
+type TaskSpec struct {
+	Kind         string
+	Name         string
+	Commands     []string
+	DependsOn    []string
+	InputMapping map[string]string
+}
+
+// Plan is the fully decoded contents of a builds.hcl file.
+type Plan struct {
+	Settings Settings
+	Builds   []BuildSpec
+	Tasks    []TaskSpec
+}
+
+// node is a dependency-graph entry shared by builds and tasks so they can be
+// topologically ordered together.
+type node struct {
+	name      string
+	dependsOn []string
+}
+
+// Order returns build and task names topologically sorted so that every
+// name appears after everything it depends on. It returns an error if the
+// dependency graph contains a cycle or references an unknown name.
+func (p *Plan) Order() ([]string, error) {
+	nodes := make(map[string]node, len(p.Builds)+len(p.Tasks))
+	for _, b := range p.Builds {
+		nodes[b.Name] = node{name: b.Name, dependsOn: b.DependsOn}
+	}
+	for _, t := range p.Tasks {
+		nodes[t.Name] = node{name: t.Name, dependsOn: t.DependsOn}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(nodes))
+	var order []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("build: dependency cycle detected at %q", name)
+		}
+		n, ok := nodes[name]
+		if !ok {
+			return fmt.Errorf("build: unknown dependency %q", name)
+		}
+		state[name] = visiting
+		for _, dep := range n.dependsOn {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = done
+		order = append(order, name)
+		return nil
+	}
+
+	for name := range nodes {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// Build looks up a build spec by name.
+func (p *Plan) Build(name string) (*BuildSpec, bool) {
+	for i := range p.Builds {
+		if p.Builds[i].Name == name {
+			return &p.Builds[i], true
+		}
+	}
+	return nil, false
+}
+
+// BuildCommand renders the `go build` invocation for the named build spec,
+// expanding its flags and ldflags. ApplyBuildPlan uses this to populate
+// LanguageConfig.BuildTools.BuildCommand from a loaded plan, and
+// GetGoConfig renders its default through it from a built-in plan.
+func (p *Plan) BuildCommand(name string) (string, error) {
+	b, ok := p.Build(name)
+	if !ok {
+		return "", fmt.Errorf("build: no build spec named %q", name)
+	}
+
+	cmd := "go build"
+	if b.Binary != "" {
+		cmd += fmt.Sprintf(" -o %s", b.Binary)
+	}
+	for _, ld := range b.LDFlags {
+		cmd += fmt.Sprintf(" -ldflags %q", ld)
+	}
+	for _, f := range b.Flags {
+		cmd += " " + f
+	}
+	if b.Main != "" {
+		cmd += " " + b.Main
+	}
+	return cmd, nil
+}
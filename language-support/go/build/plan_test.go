@@ -0,0 +1,99 @@
+package build
+
+import "testing"
+
+// indexOf returns the position of name in order, or -1 if absent.
+func indexOf(order []string, name string) int {
+	for i, n := range order {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestPlanOrderTopologicalSort(t *testing.T) {
+	p := &Plan{
+		Builds: []BuildSpec{
+			{Name: "server", Kind: "go_build", DependsOn: []string{"lint"}},
+		},
+		Tasks: []TaskSpec{
+			{Name: "lint", Kind: "script"},
+			{Name: "release", Kind: "script", DependsOn: []string{"server"}},
+		},
+	}
+
+	order, err := p.Order()
+	if err != nil {
+		t.Fatalf("Order(): %v", err)
+	}
+	if len(order) != 3 {
+		t.Fatalf("Order() returned %d names, want 3: %v", len(order), order)
+	}
+
+	lint, server, release := indexOf(order, "lint"), indexOf(order, "server"), indexOf(order, "release")
+	if lint > server {
+		t.Errorf("lint (%d) must come before server (%d): %v", lint, server, order)
+	}
+	if server > release {
+		t.Errorf("server (%d) must come before release (%d): %v", server, release, order)
+	}
+}
+
+func TestPlanOrderDetectsCycle(t *testing.T) {
+	p := &Plan{
+		Builds: []BuildSpec{
+			{Name: "a", DependsOn: []string{"b"}},
+		},
+		Tasks: []TaskSpec{
+			{Name: "b", DependsOn: []string{"a"}},
+		},
+	}
+
+	if _, err := p.Order(); err == nil {
+		t.Fatal("Order() on cyclic graph: want error, got nil")
+	}
+}
+
+func TestPlanOrderUnknownDependency(t *testing.T) {
+	p := &Plan{
+		Builds: []BuildSpec{
+			{Name: "a", DependsOn: []string{"missing"}},
+		},
+	}
+
+	if _, err := p.Order(); err == nil {
+		t.Fatal("Order() with unknown dependency: want error, got nil")
+	}
+}
+
+func TestPlanBuildCommand(t *testing.T) {
+	p := &Plan{
+		Builds: []BuildSpec{
+			{
+				Kind:    "go_build",
+				Name:    "server",
+				Binary:  "bin/server",
+				LDFlags: []string{"-s -w"},
+				Flags:   []string{"-trimpath"},
+				Main:    "./cmd/server",
+			},
+		},
+	}
+
+	cmd, err := p.BuildCommand("server")
+	if err != nil {
+		t.Fatalf("BuildCommand(): %v", err)
+	}
+	want := `go build -o bin/server -ldflags "-s -w" -trimpath ./cmd/server`
+	if cmd != want {
+		t.Errorf("BuildCommand() = %q, want %q", cmd, want)
+	}
+}
+
+func TestPlanBuildCommandUnknownName(t *testing.T) {
+	p := &Plan{}
+	if _, err := p.BuildCommand("missing"); err == nil {
+		t.Fatal("BuildCommand() with unknown name: want error, got nil")
+	}
+}
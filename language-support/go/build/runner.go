@@ -0,0 +1,141 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Runner executes a Plan: it walks build and task nodes in dependency
+// order, fanning a build spec out into one invocation per ForEach value,
+// and streams stdout/stderr into logs_dir/<name>.log (logs_dir/<name>_<value>.log
+// for a ForEach instance). A task's commands all write to the same log.
+type Runner struct {
+	Plan *Plan
+}
+
+// NewRunner returns a Runner for plan.
+func NewRunner(plan *Plan) *Runner {
+	return &Runner{Plan: plan}
+}
+
+// Run executes every build and task in the plan in topological order.
+func (r *Runner) Run() error {
+	order, err := r.Plan.Order()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(r.Plan.Settings.LogsDir, 0o755); err != nil {
+		return fmt.Errorf("build: creating logs dir: %w", err)
+	}
+
+	for _, name := range order {
+		if b, ok := r.Plan.Build(name); ok {
+			if err := r.runBuild(b); err != nil {
+				return err
+			}
+			continue
+		}
+		for i := range r.Plan.Tasks {
+			if r.Plan.Tasks[i].Name == name {
+				if err := r.runTask(&r.Plan.Tasks[i]); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runBuild(b *BuildSpec) error {
+	matrix := b.ForEach
+	if len(matrix) == 0 {
+		matrix = []string{""}
+	}
+
+	for _, value := range matrix {
+		instance := *b
+		goarch := instance.GOARCH
+		if value != "" {
+			goarch = value
+		}
+
+		cmd := exec.Command("go", "build")
+		if instance.Binary != "" {
+			outDir := filepath.Join(r.Plan.Settings.OutDir, instance.GOOS+"_"+goarch)
+			if err := os.MkdirAll(outDir, 0o755); err != nil {
+				return fmt.Errorf("build: creating out dir: %w", err)
+			}
+			cmd.Args = append(cmd.Args, "-o", filepath.Join(outDir, instance.Binary))
+		}
+		for _, ld := range instance.LDFlags {
+			cmd.Args = append(cmd.Args, "-ldflags", ld)
+		}
+		cmd.Args = append(cmd.Args, instance.Flags...)
+		if instance.Main != "" {
+			cmd.Args = append(cmd.Args, instance.Main)
+		}
+
+		cmd.Env = os.Environ()
+		if instance.GOOS != "" {
+			cmd.Env = append(cmd.Env, "GOOS="+instance.GOOS)
+		}
+		if goarch != "" {
+			cmd.Env = append(cmd.Env, "GOARCH="+goarch)
+		}
+		for k, v := range instance.Env {
+			cmd.Env = append(cmd.Env, k+"="+v)
+		}
+
+		logName := instance.Name
+		if value != "" {
+			logName += "_" + value
+		}
+		if err := r.runLogged(cmd, logName); err != nil {
+			return fmt.Errorf("build: %s: %w", logName, err)
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runTask(t *TaskSpec) error {
+	env := os.Environ()
+	for key, value := range t.InputMapping {
+		env = append(env, fmt.Sprintf("MASTERFLOW_INPUT_%s=%s", strings.ToUpper(key), value))
+	}
+
+	logPath := filepath.Join(r.Plan.Settings.LogsDir, t.Name+".log")
+	f, err := os.Create(logPath)
+	if err != nil {
+		return fmt.Errorf("build: creating log %s: %w", logPath, err)
+	}
+	defer f.Close()
+
+	for _, c := range t.Commands {
+		cmd := exec.Command("sh", "-c", c)
+		cmd.Env = env
+		cmd.Stdout = f
+		cmd.Stderr = f
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("build: task %s: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runLogged(cmd *exec.Cmd, name string) error {
+	logPath := filepath.Join(r.Plan.Settings.LogsDir, name+".log")
+	f, err := os.Create(logPath)
+	if err != nil {
+		return fmt.Errorf("build: creating log %s: %w", logPath, err)
+	}
+	defer f.Close()
+
+	cmd.Stdout = f
+	cmd.Stderr = f
+	return cmd.Run()
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"context"
+	"strings"
+)
+
+// ArgsProvider resolves keys from command-line flags of the form
+// --buildTools.lintCommand=value. It has the highest natural precedence of
+// the built-in providers since it reflects an explicit, one-off override.
+type ArgsProvider struct {
+	values map[Key]string
+}
+
+// NewArgsProvider parses args (typically os.Args[1:]) into a lookup table.
+func NewArgsProvider(args []string) *ArgsProvider {
+	p := &ArgsProvider{values: make(map[Key]string)}
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "--") {
+			continue
+		}
+		trimmed := strings.TrimPrefix(arg, "--")
+		k, v, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			continue
+		}
+		p.values[Key(k)] = v
+	}
+	return p
+}
+
+func (p *ArgsProvider) Name() string { return "args" }
+
+func (p *ArgsProvider) Value(key Key) (any, error) {
+	if v, ok := p.values[key]; ok {
+		return v, nil
+	}
+	return nil, ErrNotFound
+}
+
+// Watch is unsupported: command-line flags are fixed for the life of the
+// process, so Watch always returns a nil channel.
+func (p *ArgsProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	return nil, nil
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Client composes Providers in priority order: the last provider in
+// Providers wins when more than one has a value for a key. Construct it
+// with NewClient(env, file, args) so the call site reads left-to-right in
+// ascending precedence, the same order a reader would reason about
+// overrides in.
+type Client struct {
+	Providers []Provider
+}
+
+// NewClient returns a Client composing providers in ascending precedence
+// order (later providers override earlier ones).
+func NewClient(providers ...Provider) *Client {
+	return &Client{Providers: providers}
+}
+
+// Value returns the highest-precedence value set for key, or ErrNotFound if
+// no provider has one.
+func (c *Client) Value(key Key) (any, error) {
+	for i := len(c.Providers) - 1; i >= 0; i-- {
+		v, err := c.Providers[i].Value(key)
+		if err == nil {
+			return v, nil
+		}
+		if err != ErrNotFound {
+			return nil, err
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Populate walks target's JSON tags, recursing into nested struct fields
+// (e.g. "testing.kubernetes.enabled"), and overwrites any string, bool, or
+// int field for which a provider has a value. It is the mechanism
+// GetGoConfig callers use to let env vars, flags, or remote config override
+// the hard-coded defaults without forking the template.
+func (c *Client) Populate(target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: Populate requires a pointer to a struct, got %T", target)
+	}
+	return c.populateStruct(v.Elem(), "")
+}
+
+func (c *Client) populateStruct(v reflect.Value, prefix string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, _, _ := cutComma(tag)
+		key := Key(name)
+		if prefix != "" {
+			key = Key(prefix + "." + name)
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Struct {
+			if err := c.populateStruct(fv, string(key)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, err := c.Value(key)
+		if err != nil {
+			if err == ErrNotFound {
+				continue
+			}
+			return fmt.Errorf("config: resolving %q: %w", key, err)
+		}
+		assign(fv, value)
+	}
+	return nil
+}
+
+func cutComma(tag string) (name, rest string, found bool) {
+	for i := 0; i < len(tag); i++ {
+		if tag[i] == ',' {
+			return tag[:i], tag[i+1:], true
+		}
+	}
+	return tag, "", false
+}
+
+// assign sets fv from value, coercing the loosely-typed values providers
+// return (JSON/YAML numbers decode to float64, bools to bool) to fv's kind.
+// String-typed values (env vars, CLI args) are also accepted for bool/int
+// fields so a string-only provider can still populate them.
+func assign(fv reflect.Value, value any) {
+	if !fv.CanSet() {
+		return
+	}
+	switch fv.Kind() {
+	case reflect.String:
+		if s, ok := value.(string); ok {
+			fv.SetString(s)
+		}
+	case reflect.Bool:
+		switch v := value.(type) {
+		case bool:
+			fv.SetBool(v)
+		case string:
+			fv.SetBool(v == "true" || v == "1")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := value.(type) {
+		case float64:
+			fv.SetInt(int64(v))
+		case int:
+			fv.SetInt(int64(v))
+		case int64:
+			fv.SetInt(v)
+		case string:
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return
+			}
+			fv.SetInt(n)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// mapProvider is a fixed in-memory Provider used to exercise Client without
+// touching disk, env, etcd, or Vault.
+type mapProvider struct {
+	values map[Key]any
+	err    error
+}
+
+func (p *mapProvider) Name() string { return "map" }
+
+func (p *mapProvider) Value(key Key) (any, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	v, ok := p.values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return v, nil
+}
+
+func (p *mapProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	return nil, nil
+}
+
+type populateTarget struct {
+	DevServer struct {
+		DefaultPort int    `json:"defaultPort"`
+		HotReload   bool   `json:"hotReload"`
+		Command     string `json:"command"`
+	} `json:"devServer"`
+}
+
+func TestPopulateCoercesJSONTypedValues(t *testing.T) {
+	p := &mapProvider{values: map[Key]any{
+		"devServer.defaultPort": float64(9090), // encoding/json decodes numbers to float64
+		"devServer.hotReload":   true,
+		"devServer.command":     "air",
+	}}
+	c := NewClient(p)
+
+	var target populateTarget
+	if err := c.Populate(&target); err != nil {
+		t.Fatalf("Populate: %v", err)
+	}
+
+	if target.DevServer.DefaultPort != 9090 {
+		t.Errorf("DefaultPort = %d, want 9090", target.DevServer.DefaultPort)
+	}
+	if !target.DevServer.HotReload {
+		t.Error("HotReload = false, want true")
+	}
+	if target.DevServer.Command != "air" {
+		t.Errorf("Command = %q, want %q", target.DevServer.Command, "air")
+	}
+}
+
+func TestPopulateCoercesStringValues(t *testing.T) {
+	p := &mapProvider{values: map[Key]any{
+		"devServer.defaultPort": "9090",
+		"devServer.hotReload":   "true",
+	}}
+	c := NewClient(p)
+
+	var target populateTarget
+	if err := c.Populate(&target); err != nil {
+		t.Fatalf("Populate: %v", err)
+	}
+
+	if target.DevServer.DefaultPort != 9090 {
+		t.Errorf("DefaultPort = %d, want 9090", target.DevServer.DefaultPort)
+	}
+	if !target.DevServer.HotReload {
+		t.Error("HotReload = false, want true")
+	}
+}
+
+func TestPopulateLeavesFieldUnsetWhenNotFound(t *testing.T) {
+	p := &mapProvider{values: map[Key]any{}}
+	c := NewClient(p)
+
+	target := populateTarget{}
+	target.DevServer.DefaultPort = 8080
+	if err := c.Populate(&target); err != nil {
+		t.Fatalf("Populate: %v", err)
+	}
+	if target.DevServer.DefaultPort != 8080 {
+		t.Errorf("DefaultPort = %d, want unchanged default 8080", target.DevServer.DefaultPort)
+	}
+}
+
+func TestPopulatePropagatesProviderErrors(t *testing.T) {
+	wantErr := errors.New("etcd: connection refused")
+	p := &mapProvider{err: wantErr}
+	c := NewClient(p)
+
+	var target populateTarget
+	err := c.Populate(&target)
+	if err == nil {
+		t.Fatal("Populate: want error, got nil")
+	}
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Populate error = %v, want it to wrap %v", err, wantErr)
+	}
+}
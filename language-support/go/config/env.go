@@ -0,0 +1,44 @@
+package config
+
+import (
+	"context"
+	"os"
+	"strings"
+)
+
+// EnvProvider resolves keys against environment variables. A dotted key such
+// as "buildTools.lintCommand" is looked up as MASTERFLOW_BUILDTOOLS_LINTCOMMAND,
+// using prefix as the leading segment (default "MASTERFLOW").
+type EnvProvider struct {
+	Prefix string
+}
+
+// NewEnvProvider returns an EnvProvider using prefix, or "MASTERFLOW" if empty.
+func NewEnvProvider(prefix string) *EnvProvider {
+	if prefix == "" {
+		prefix = "MASTERFLOW"
+	}
+	return &EnvProvider{Prefix: prefix}
+}
+
+func (p *EnvProvider) Name() string { return "env" }
+
+func (p *EnvProvider) envName(key Key) string {
+	segments := key.Segments()
+	name := p.Prefix + "_" + strings.Join(segments, "_")
+	return strings.ToUpper(name)
+}
+
+func (p *EnvProvider) Value(key Key) (any, error) {
+	if v, ok := os.LookupEnv(p.envName(key)); ok {
+		return v, nil
+	}
+	return nil, ErrNotFound
+}
+
+// Watch is unsupported for environment variables: there is no change
+// notification for the process environment, so Watch always returns a nil
+// channel.
+func (p *EnvProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	return nil, nil
+}
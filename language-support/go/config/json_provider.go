@@ -0,0 +1,91 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"reflect"
+	"time"
+)
+
+// JSONProvider resolves keys against a JSON document on disk, re-read on
+// every Value call so edits take effect without restarting the process.
+type JSONProvider struct {
+	Path string
+}
+
+// NewJSONProvider returns a JSONProvider reading from path.
+func NewJSONProvider(path string) *JSONProvider {
+	return &JSONProvider{Path: path}
+}
+
+func (p *JSONProvider) Name() string { return "json:" + p.Path }
+
+func (p *JSONProvider) load() (map[string]any, error) {
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (p *JSONProvider) Value(key Key) (any, error) {
+	doc, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+	return lookup(doc, key.Segments())
+}
+
+// lookup walks a nested map[string]any following segments.
+func lookup(doc map[string]any, segments []string) (any, error) {
+	var cur any = doc
+	for _, seg := range segments {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, ErrNotFound
+		}
+		v, ok := m[seg]
+		if !ok {
+			return nil, ErrNotFound
+		}
+		cur = v
+	}
+	return cur, nil
+}
+
+// Watch re-reads the value at key once a second and emits an Event whenever
+// it changes. It stops when ctx is canceled.
+func (p *JSONProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	ch := make(chan Event)
+	go func() {
+		defer close(ch)
+		var last any
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				v, err := p.Value(key)
+				if err != nil {
+					continue
+				}
+				if !reflect.DeepEqual(v, last) {
+					last = v
+					select {
+					case ch <- Event{Key: key, Value: v}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -0,0 +1,41 @@
+/*
+Package config hydrates a LanguageConfig-shaped struct from layered
+configuration sources. Providers are composed in priority order through a
+Client: later providers override earlier ones, mirroring the precedence a
+user would expect from env vars beating defaults and CLI flags beating env
+vars.
+*/
+package config
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// Key is a dotted path into a configuration tree, e.g. "buildTools.lintCommand".
+type Key string
+
+// Segments splits the key into its dotted path components.
+func (k Key) Segments() []string {
+	return strings.Split(string(k), ".")
+}
+
+// ErrNotFound is returned by a Provider when it has no value for a key.
+var ErrNotFound = errors.New("config: key not found")
+
+// Event describes a value change pushed by a Watch channel.
+type Event struct {
+	Key   Key
+	Value any
+}
+
+// Provider is a single configuration source. Value looks up a key
+// synchronously; Watch (optional) pushes Events when the underlying source
+// changes. Providers that cannot watch should return a nil channel and a
+// nil error.
+type Provider interface {
+	Name() string
+	Value(key Key) (any, error)
+	Watch(ctx context.Context, key Key) (<-chan Event, error)
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"context"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// EtcdProvider resolves keys against an etcd key-value store, mapping a
+// dotted Key to "<Prefix>/<slash-separated-path>".
+type EtcdProvider struct {
+	Client *clientv3.Client
+	Prefix string
+}
+
+// NewEtcdProvider returns an EtcdProvider rooted at prefix, backed by client.
+func NewEtcdProvider(client *clientv3.Client, prefix string) *EtcdProvider {
+	return &EtcdProvider{Client: client, Prefix: prefix}
+}
+
+func (p *EtcdProvider) Name() string { return "etcd:" + p.Prefix }
+
+func (p *EtcdProvider) path(key Key) string {
+	return p.Prefix + "/" + joinSegments(key.Segments())
+}
+
+func (p *EtcdProvider) Value(key Key) (any, error) {
+	resp, err := p.Client.Get(context.Background(), p.path(key))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, ErrNotFound
+	}
+	return string(resp.Kvs[0].Value), nil
+}
+
+// Watch streams etcd's native watch events for key, translating each PUT
+// into an Event. It stops when ctx is canceled.
+func (p *EtcdProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	watchCh := p.Client.Watch(ctx, p.path(key))
+	ch := make(chan Event)
+	go func() {
+		defer close(ch)
+		for resp := range watchCh {
+			for _, ev := range resp.Events {
+				if ev.Type != clientv3.EventTypePut {
+					continue
+				}
+				select {
+				case ch <- Event{Key: key, Value: string(ev.Kv.Value)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// VaultProvider resolves keys against a HashiCorp Vault KV secret, mapping a
+// dotted Key to a field within a single secret path.
+type VaultProvider struct {
+	Client     *vaultapi.Client
+	SecretPath string
+}
+
+// NewVaultProvider returns a VaultProvider reading the secret at secretPath,
+// backed by client.
+func NewVaultProvider(client *vaultapi.Client, secretPath string) *VaultProvider {
+	return &VaultProvider{Client: client, SecretPath: secretPath}
+}
+
+func (p *VaultProvider) Name() string { return "vault:" + p.SecretPath }
+
+func (p *VaultProvider) Value(key Key) (any, error) {
+	secret, err := p.Client.Logical().Read(p.SecretPath)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, ErrNotFound
+	}
+
+	data := secret.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		// KV v2 engines nest the actual payload under a "data" field.
+		data = nested
+	}
+	return lookup(data, key.Segments())
+}
+
+// Watch is unsupported: Vault's KV secrets engine has no change feed to
+// watch, so Watch always returns a nil channel.
+func (p *VaultProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	return nil, nil
+}
+
+func joinSegments(segments []string) string {
+	out := ""
+	for i, s := range segments {
+		if i > 0 {
+			out += "/"
+		}
+		out += s
+	}
+	return out
+}
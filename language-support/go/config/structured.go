@@ -0,0 +1,142 @@
+package config
+
+import (
+	"context"
+	"os"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/ini.v1"
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLProvider resolves keys against a YAML document on disk, parsed with
+// gopkg.in/yaml.v3. Decode can be overridden (e.g. in tests) but defaults
+// to decodeYAMLFile.
+type YAMLProvider struct {
+	Path   string
+	Decode func(path string) (map[string]any, error)
+}
+
+// NewYAMLProvider returns a YAMLProvider that parses path as YAML.
+func NewYAMLProvider(path string) *YAMLProvider {
+	return &YAMLProvider{Path: path}
+}
+
+func (p *YAMLProvider) Name() string { return "yaml:" + p.Path }
+
+func (p *YAMLProvider) Value(key Key) (any, error) {
+	decode := p.Decode
+	if decode == nil {
+		decode = decodeYAMLFile
+	}
+	doc, err := decode(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	return lookup(doc, key.Segments())
+}
+
+func (p *YAMLProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	return nil, nil
+}
+
+func decodeYAMLFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// TOMLProvider resolves keys against a TOML document on disk, parsed with
+// github.com/BurntSushi/toml. Decode can be overridden (e.g. in tests) but
+// defaults to decodeTOMLFile.
+type TOMLProvider struct {
+	Path   string
+	Decode func(path string) (map[string]any, error)
+}
+
+// NewTOMLProvider returns a TOMLProvider that parses path as TOML.
+func NewTOMLProvider(path string) *TOMLProvider {
+	return &TOMLProvider{Path: path}
+}
+
+func (p *TOMLProvider) Name() string { return "toml:" + p.Path }
+
+func (p *TOMLProvider) Value(key Key) (any, error) {
+	decode := p.Decode
+	if decode == nil {
+		decode = decodeTOMLFile
+	}
+	doc, err := decode(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	return lookup(doc, key.Segments())
+}
+
+func (p *TOMLProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	return nil, nil
+}
+
+func decodeTOMLFile(path string) (map[string]any, error) {
+	var doc map[string]any
+	if _, err := toml.DecodeFile(path, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// INIProvider resolves keys against an INI document, parsed with
+// gopkg.in/ini.v1, treating each section as one path segment (e.g.
+// [buildTools] lintCommand = ... maps to "buildTools.lintCommand"). Decode
+// can be overridden (e.g. in tests) but defaults to decodeINIFile.
+type INIProvider struct {
+	Path   string
+	Decode func(path string) (map[string]any, error)
+}
+
+// NewINIProvider returns an INIProvider that parses path as INI.
+func NewINIProvider(path string) *INIProvider {
+	return &INIProvider{Path: path}
+}
+
+func (p *INIProvider) Name() string { return "ini:" + p.Path }
+
+func (p *INIProvider) Value(key Key) (any, error) {
+	decode := p.Decode
+	if decode == nil {
+		decode = decodeINIFile
+	}
+	doc, err := decode(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	return lookup(doc, key.Segments())
+}
+
+func (p *INIProvider) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	return nil, nil
+}
+
+func decodeINIFile(path string) (map[string]any, error) {
+	cfg, err := ini.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := make(map[string]any, len(cfg.Sections()))
+	for _, section := range cfg.Sections() {
+		keys := section.Keys()
+		values := make(map[string]any, len(keys))
+		for _, k := range keys {
+			values[k.Name()] = k.String()
+		}
+		doc[section.Name()] = values
+	}
+	return doc, nil
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Watcher wraps a Provider that only supports polling and turns it into one
+// that also satisfies the Watch side of the Provider interface by re-reading
+// Value on a fixed interval and pushing an Event whenever it changes.
+type Watcher struct {
+	Provider Provider
+	Interval time.Duration
+}
+
+// NewWatcher returns a Watcher polling provider every interval, defaulting
+// to 5 seconds if interval is zero.
+func NewWatcher(provider Provider, interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	return &Watcher{Provider: provider, Interval: interval}
+}
+
+func (w *Watcher) Name() string { return "watch:" + w.Provider.Name() }
+
+func (w *Watcher) Value(key Key) (any, error) {
+	return w.Provider.Value(key)
+}
+
+// Watch polls the wrapped Provider's Value and emits an Event on the
+// returned channel whenever it changes. The channel is closed when ctx is
+// canceled.
+func (w *Watcher) Watch(ctx context.Context, key Key) (<-chan Event, error) {
+	ch := make(chan Event)
+	go func() {
+		defer close(ch)
+		var last any
+		ticker := time.NewTicker(w.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				v, err := w.Provider.Value(key)
+				if err != nil {
+					continue
+				}
+				if !reflect.DeepEqual(v, last) {
+					last = v
+					select {
+					case ch <- Event{Key: key, Value: v}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -0,0 +1,126 @@
+package languages
+
+import (
+	"fmt"
+
+	"github.com/Beaulewis1977/master-workflow/language-support/go/release"
+)
+
+// LanguageConfig is the configuration shape every registered Language
+// produces from its Config method.
+type LanguageConfig struct {
+	Language   string                 `json:"language"`
+	Extensions []string               `json:"extensions"`
+	BuildTools BuildTools             `json:"buildTools"`
+	Frameworks map[string][]string    `json:"frameworks"`
+	Testing    TestingConfig          `json:"testing"`
+	Linting    LintingConfig          `json:"linting"`
+	Patterns   map[string]interface{} `json:"patterns"`
+	DevServer  DevServerConfig        `json:"devServer"`
+	Deployment DeploymentConfig       `json:"deployment"`
+}
+
+// BuildTools configuration for a language's build pipeline.
+type BuildTools struct {
+	PackageManager      string   `json:"packageManager"`
+	ModuleSystem        string   `json:"moduleSystem"`
+	BuildCommand        string   `json:"buildCommand"`
+	TestCommand         string   `json:"testCommand"`
+	LintCommand         string   `json:"lintCommand"`
+	FormatCommand       string   `json:"formatCommand"`
+	VendorCommand       string   `json:"vendorCommand"`
+	ModInit             string   `json:"modInit"`
+	AlternativeManagers []string `json:"alternativeManagers"`
+}
+
+// TestingConfig describes a language's testing frameworks and harnesses.
+type TestingConfig struct {
+	Unit       []string         `json:"unit"`
+	Benchmark  []string         `json:"benchmark"`
+	Coverage   []string         `json:"coverage"`
+	E2E        []string         `json:"e2e"`
+	ConfigFile string           `json:"configFile"`
+	Kubernetes KubernetesConfig `json:"kubernetes"`
+}
+
+// KubernetesConfig points a microservice template at the Kubernetes e2e
+// harness in the test subpackage: a directory of test cases applied against
+// a real or KIND-provisioned cluster.
+type KubernetesConfig struct {
+	Enabled         bool   `json:"enabled"`
+	CasesDir        string `json:"casesDir"`
+	Kubeconfig      string `json:"kubeconfig"`
+	UseKind         bool   `json:"useKind"`
+	KindClusterName string `json:"kindClusterName"`
+	Timeout         string `json:"timeout"`
+}
+
+// LintingConfig for a language's linting and formatting tools.
+type LintingConfig struct {
+	Linter          string        `json:"linter"`
+	Config          string        `json:"config"`
+	Formatter       string        `json:"formatter"`
+	StaticAnalysis  []string      `json:"staticAnalysis"`
+	SecurityScanner []string      `json:"securityScanner"`
+	CustomBinary    *CustomBinary `json:"customBinary,omitempty"`
+}
+
+// CustomBinary describes a custom linter binary built with third-party
+// linter plugins, e.g. via `golangci-lint custom`.
+type CustomBinary struct {
+	Name        string       `json:"name"`
+	Destination string       `json:"destination"`
+	BaseVersion string       `json:"baseVersion"`
+	Plugins     []PluginSpec `json:"plugins"`
+}
+
+// PluginSpec describes a single linter plugin to build into a custom binary.
+// Either Version or Path must be set; when Path is set it takes precedence
+// over the module proxy version, letting a plugin be developed locally.
+type PluginSpec struct {
+	Module  string `json:"module"`
+	Version string `json:"version,omitempty"`
+	Import  string `json:"import,omitempty"`
+	Path    string `json:"path,omitempty"`
+}
+
+// Validate checks that the plugin specifies either a proxy version or a
+// local path to build from.
+func (p PluginSpec) Validate() error {
+	if p.Version == "" && p.Path == "" {
+		return fmt.Errorf("plugin %q: either version or path must be set", p.Module)
+	}
+	return nil
+}
+
+// Validate checks that the custom binary spec is well-formed and that every
+// plugin it references is valid.
+func (cb *CustomBinary) Validate() error {
+	if cb.Name == "" {
+		return fmt.Errorf("custom binary: name is required")
+	}
+	for _, p := range cb.Plugins {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("custom binary %q: %w", cb.Name, err)
+		}
+	}
+	return nil
+}
+
+// DevServerConfig for a language's local development server.
+type DevServerConfig struct {
+	Command     string `json:"command"`
+	DefaultPort int    `json:"defaultPort"`
+	HotReload   bool   `json:"hotReload"`
+	WatchTool   string `json:"watchTool"`
+}
+
+// DeploymentConfig for a language's deployment options.
+type DeploymentConfig struct {
+	Platforms        []string              `json:"platforms"`
+	Containerization string                `json:"containerization"`
+	CI               []string              `json:"ci"`
+	CloudNative      []string              `json:"cloudNative"`
+	CrossCompile     []release.TargetSpec  `json:"crossCompile"`
+	Package          []release.PackageSpec `json:"package"`
+}
@@ -0,0 +1,86 @@
+package languages
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCustomBinaryJSONRoundTrip(t *testing.T) {
+	want := &CustomBinary{
+		Name:        "golangci-lint-custom",
+		Destination: "./bin",
+		BaseVersion: "v1.55.2",
+		Plugins: []PluginSpec{
+			{Module: "github.com/example/plugin", Version: "v1.0.0", Import: "github.com/example/plugin/analyzer"},
+			{Module: "github.com/example/local", Path: "../local-plugin"},
+		},
+	}
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var got CustomBinary
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	gotData, err := json.Marshal(&got)
+	if err != nil {
+		t.Fatalf("Marshal round-tripped value: %v", err)
+	}
+	wantData, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal want: %v", err)
+	}
+	if string(gotData) != string(wantData) {
+		t.Fatalf("round trip mismatch:\n got: %s\nwant: %s", gotData, wantData)
+	}
+}
+
+func TestPluginSpecValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    PluginSpec
+		wantErr bool
+	}{
+		{"version set", PluginSpec{Module: "m", Version: "v1.0.0"}, false},
+		{"path set", PluginSpec{Module: "m", Path: "../local"}, false},
+		{"both set", PluginSpec{Module: "m", Version: "v1.0.0", Path: "../local"}, false},
+		{"neither set", PluginSpec{Module: "m"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomBinaryValidate(t *testing.T) {
+	valid := &CustomBinary{
+		Name: "golangci-lint-custom",
+		Plugins: []PluginSpec{
+			{Module: "github.com/example/plugin", Version: "v1.0.0"},
+		},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate() on valid spec: %v", err)
+	}
+
+	noName := &CustomBinary{}
+	if err := noName.Validate(); err == nil {
+		t.Fatal("Validate() on spec with no name: want error, got nil")
+	}
+
+	badPlugin := &CustomBinary{
+		Name:    "golangci-lint-custom",
+		Plugins: []PluginSpec{{Module: "github.com/example/plugin"}},
+	}
+	if err := badPlugin.Validate(); err == nil {
+		t.Fatal("Validate() on spec with invalid plugin: want error, got nil")
+	}
+}
@@ -0,0 +1,57 @@
+package golang
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/Beaulewis1977/master-workflow/language-support/go/languages"
+)
+
+// WriteCustomGCLConfig renders cb as a .custom-gcl.yml manifest understood by
+// `golangci-lint custom` and writes it to path.
+func WriteCustomGCLConfig(cb *languages.CustomBinary, path string) error {
+	if err := cb.Validate(); err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "version: %s\n", cb.BaseVersion)
+	fmt.Fprintf(&b, "name: %s\n", cb.Name)
+	fmt.Fprintf(&b, "destination: %s\n", cb.Destination)
+	b.WriteString("plugins:\n")
+	for _, p := range cb.Plugins {
+		fmt.Fprintf(&b, "  - module: %s\n", p.Module)
+		if p.Version != "" {
+			fmt.Fprintf(&b, "    version: %s\n", p.Version)
+		}
+		if p.Import != "" {
+			fmt.Fprintf(&b, "    import: %s\n", p.Import)
+		}
+		if p.Path != "" {
+			fmt.Fprintf(&b, "    path: %s\n", p.Path)
+		}
+	}
+
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+// BuildCustomLinter writes the .custom-gcl.yml manifest for cb into dir and
+// invokes `golangci-lint custom` there to produce the custom binary.
+func BuildCustomLinter(cb *languages.CustomBinary, dir string) error {
+	manifest := filepath.Join(dir, ".custom-gcl.yml")
+	if err := WriteCustomGCLConfig(cb, manifest); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("golangci-lint", "custom")
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("golangci-lint custom: %w", err)
+	}
+	return nil
+}
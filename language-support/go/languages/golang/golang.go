@@ -0,0 +1,146 @@
+/*
+Package golang is MASTER-WORKFLOW's Go language template: it implements
+languages.Language and registers itself under the name "go" so main can
+detect and dispatch to it without special-casing Go anywhere else.
+*/
+package golang
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/Beaulewis1977/master-workflow/language-support/go/languages"
+	"github.com/Beaulewis1977/master-workflow/language-support/go/release"
+)
+
+func init() {
+	languages.Register("go", Language{})
+}
+
+// Language is MASTER-WORKFLOW's Go template.
+type Language struct{}
+
+// Config returns the complete Go language configuration.
+func (Language) Config() *languages.LanguageConfig {
+	return GetGoConfig()
+}
+
+// Detect reports whether fsys has a go.mod at its root.
+func (Language) Detect(fsys fs.FS) bool {
+	_, err := fs.Stat(fsys, "go.mod")
+	return err == nil
+}
+
+// Scaffold lays down a new Go module in dir: `go mod init` using dir's base
+// name as the module path, plus a minimal main.go.
+func (Language) Scaffold(ctx context.Context, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	module := filepath.Base(dir)
+	cmd := exec.CommandContext(ctx, "go", "mod", "init", module)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("golang: go mod init: %w: %s", err, out)
+	}
+
+	main := []byte("package main\n\nfunc main() {}\n")
+	return os.WriteFile(filepath.Join(dir, "main.go"), main, 0o644)
+}
+
+// GetGoConfig returns the complete Go language configuration.
+func GetGoConfig() *languages.LanguageConfig {
+	return &languages.LanguageConfig{
+		Language:   "go",
+		Extensions: []string{".go", ".mod", ".sum"},
+		BuildTools: languages.BuildTools{
+			PackageManager:      "go modules",
+			ModuleSystem:        "go.mod",
+			BuildCommand:        defaultBuildCommand(),
+			TestCommand:         "go test ./...",
+			LintCommand:         "golangci-lint run",
+			FormatCommand:       "gofmt -s -w .",
+			VendorCommand:       "go mod vendor",
+			ModInit:             "go mod init",
+			AlternativeManagers: []string{"dep", "glide", "godep"},
+		},
+		Frameworks: map[string][]string{
+			"web":           {"Gin", "Echo", "Fiber", "Chi", "Gorilla Mux", "Buffalo"},
+			"grpc":          {"gRPC-Go", "Twirp", "Connect"},
+			"orm":           {"GORM", "Ent", "SQLBoiler", "Squirrel"},
+			"testing":       {"Testify", "GoConvey", "Ginkgo", "Gomega"},
+			"cli":           {"Cobra", "Urfave CLI", "Kong", "Kingpin"},
+			"microservices": {"Go-kit", "Micro", "Kratos", "Go-chassis"},
+		},
+		Testing: languages.TestingConfig{
+			Unit:       []string{"testing", "testify/assert", "testify/mock", "testify/suite"},
+			Benchmark:  []string{"testing.B", "benchstat", "gobench"},
+			Coverage:   []string{"go test -cover", "gocov", "gcov2lcov"},
+			E2E:        []string{"Selenium", "Agouti", "Chromedp"},
+			ConfigFile: ".golangci.yml",
+			Kubernetes: languages.KubernetesConfig{
+				Enabled:         false,
+				CasesDir:        "testdata/k8s",
+				Kubeconfig:      "",
+				UseKind:         true,
+				KindClusterName: "masterflow-e2e",
+				Timeout:         "2m",
+			},
+		},
+		Linting: languages.LintingConfig{
+			Linter:          "golangci-lint",
+			Config:          ".golangci.yml",
+			Formatter:       "gofmt",
+			StaticAnalysis:  []string{"go vet", "staticcheck", "gosec", "ineffassign"},
+			SecurityScanner: []string{"gosec", "snyk", "nancy"},
+			CustomBinary: &languages.CustomBinary{
+				Name:        "golangci-lint-custom",
+				Destination: "./bin",
+				BaseVersion: "v1.55.2",
+				Plugins:     []languages.PluginSpec{},
+			},
+		},
+		Patterns: map[string]interface{}{
+			"concurrency":     "goroutines and channels",
+			"errorHandling":   "explicit error returns",
+			"interfaceDesign": "small interfaces",
+			"dependency":      "dependency injection",
+			"configuration":   "environment variables and config structs",
+		},
+		DevServer: languages.DevServerConfig{
+			Command:     "go run main.go",
+			DefaultPort: 8080,
+			HotReload:   false,
+			WatchTool:   "air",
+		},
+		Deployment: languages.DeploymentConfig{
+			Platforms:        []string{"Google Cloud", "AWS", "Azure", "Digital Ocean", "Heroku"},
+			Containerization: "Docker",
+			CI:               []string{"GitHub Actions", "GitLab CI", "Jenkins", "CircleCI"},
+			CloudNative:      []string{"Kubernetes", "Docker Swarm", "Nomad"},
+			CrossCompile: []release.TargetSpec{
+				{GOOS: "linux", GOARCH: "amd64", OutputTemplate: "{{.Name}}_{{.GOOS}}_{{.GOARCH}}"},
+				{GOOS: "linux", GOARCH: "arm64", OutputTemplate: "{{.Name}}_{{.GOOS}}_{{.GOARCH}}"},
+				{GOOS: "darwin", GOARCH: "amd64", OutputTemplate: "{{.Name}}_{{.GOOS}}_{{.GOARCH}}"},
+				{GOOS: "darwin", GOARCH: "arm64", OutputTemplate: "{{.Name}}_{{.GOOS}}_{{.GOARCH}}"},
+				{GOOS: "windows", GOARCH: "amd64", OutputTemplate: "{{.Name}}_{{.GOOS}}_{{.GOARCH}}"},
+			},
+			Package: []release.PackageSpec{
+				{Format: "tar_gz", InputMapping: map[string]string{
+					"linux_amd64":  "bin/app",
+					"linux_arm64":  "bin/app",
+					"darwin_amd64": "bin/app",
+					"darwin_arm64": "bin/app",
+				}},
+				{Format: "zip", InputMapping: map[string]string{
+					"windows_amd64": "app.exe",
+				}},
+			},
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package golang
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beaulewis1977/master-workflow/language-support/go/build"
+	"github.com/Beaulewis1977/master-workflow/language-support/go/languages"
+	"github.com/Beaulewis1977/master-workflow/language-support/go/release"
+	"github.com/Beaulewis1977/master-workflow/language-support/go/test"
+)
+
+// ApplyBuildPlan derives cfg.BuildTools.BuildCommand from the named build in
+// plan, so a project carrying a .masterflow/builds.hcl file gets its build
+// command from that plan instead of the template's built-in default.
+func ApplyBuildPlan(cfg *languages.LanguageConfig, plan *build.Plan, buildName string) error {
+	cmd, err := plan.BuildCommand(buildName)
+	if err != nil {
+		return err
+	}
+	cfg.BuildTools.BuildCommand = cmd
+	return nil
+}
+
+// defaultBuildCommand renders GetGoConfig's built-in build command through
+// the same build.Plan.BuildCommand logic a real .masterflow/builds.hcl
+// drives, so the default is derived from a (single, built-in) plan rather
+// than a hard-coded "go build" string.
+func defaultBuildCommand() string {
+	plan := &build.Plan{Builds: []build.BuildSpec{{Kind: "go_build", Name: "default"}}}
+	cmd, err := plan.BuildCommand("default")
+	if err != nil {
+		return "go build"
+	}
+	return cmd
+}
+
+// RunKubernetesTests loads every case under cfg.CasesDir and runs it against
+// the cluster named by cfg (reusing cfg.Kubeconfig, or a disposable KIND
+// cluster when UseKind is set), using the built-in JSON-compatible decoder
+// for assert/apply files.
+func RunKubernetesTests(cfg languages.KubernetesConfig) error {
+	if !cfg.Enabled {
+		return fmt.Errorf("kubernetes testing is disabled in LanguageConfig.Testing.Kubernetes")
+	}
+
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil {
+		return fmt.Errorf("parsing kubernetes testing timeout %q: %w", cfg.Timeout, err)
+	}
+
+	cases, err := test.LoadCases(cfg.CasesDir)
+	if err != nil {
+		return err
+	}
+
+	harness := test.NewHarness(cfg.Kubeconfig, cfg.UseKind, cfg.KindClusterName)
+	ctx := context.Background()
+	if err := harness.Start(ctx); err != nil {
+		return err
+	}
+	defer harness.Stop(ctx)
+
+	runner := test.NewRunner(harness, test.JSONDecode, timeout)
+	for _, c := range cases {
+		if err := runner.Run(ctx, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunReleaseBuild builds every target in cfg.CrossCompile into ./dist and
+// packages the results according to cfg.Package.
+func RunReleaseBuild(cfg languages.DeploymentConfig) error {
+	ctx := context.Background()
+	opts := release.BuildOptions{
+		BinaryName: "app",
+		Main:       ".",
+		OutDir:     "dist",
+	}
+	if err := release.BuildAll(ctx, cfg.CrossCompile, opts); err != nil {
+		return err
+	}
+	return release.PackageAll(ctx, opts.OutDir, cfg.CrossCompile, cfg.Package, opts.BinaryName)
+}
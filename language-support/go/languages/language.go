@@ -0,0 +1,76 @@
+/*
+Package languages defines the plugin surface MASTER-WORKFLOW's language
+templates implement. Each supported language registers itself under a
+short name (e.g. "go", "rust", "python", "node"); main detects which one
+applies to the working directory and dispatches to it, rather than hard-
+coding a single language's config struct.
+*/
+package languages
+
+import (
+	"context"
+	"io/fs"
+	"sync"
+)
+
+// Language is implemented by every language template MASTER-WORKFLOW ships.
+type Language interface {
+	// Config returns this language's complete configuration.
+	Config() *LanguageConfig
+	// Detect reports whether fsys looks like a project of this language,
+	// e.g. by the presence of go.mod, Cargo.toml, package.json, or
+	// pyproject.toml at its root.
+	Detect(fsys fs.FS) bool
+	// Scaffold lays down a new project of this language in dir.
+	Scaffold(ctx context.Context, dir string) error
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Language{}
+)
+
+// Register adds a Language implementation under name. It is typically
+// called from an init func in the implementation's package, e.g.
+// languages/golang.
+func Register(name string, l Language) {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[name] = l
+}
+
+// Get returns the Language registered under name, if any.
+func Get(name string) (Language, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	l, ok := registry[name]
+	return l, ok
+}
+
+// Names returns every registered language name.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Detect returns the first registered Language whose Detect method reports
+// true for fsys, along with the name it was registered under. Iteration
+// order over the registry is unspecified, so a directory matched by more
+// than one language's Detect is a configuration error the caller should
+// avoid (e.g. a repo that is both a Go module and has a package.json for
+// unrelated tooling).
+func Detect(fsys fs.FS) (Language, string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for name, l := range registry {
+		if l.Detect(fsys) {
+			return l, name, true
+		}
+	}
+	return nil, "", false
+}
@@ -0,0 +1,91 @@
+/*
+MASTER-WORKFLOW language template dispatcher.
+
+Detects the language of the working directory and dispatches to its
+registered languages.Language implementation, rather than hard-coding a
+single language's config struct. Only the Go template (languages/golang)
+ships today; Rust, Python, and Node variants register themselves the same
+way once added, with no changes needed here.
+*/
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/Beaulewis1977/master-workflow/language-support/go/build"
+	"github.com/Beaulewis1977/master-workflow/language-support/go/languages"
+	"github.com/Beaulewis1977/master-workflow/language-support/go/languages/golang"
+)
+
+// buildPlanFile is where a project's own build/task DSL lives, if it has
+// one; main applies it over the template's built-in BuildCommand default.
+const buildPlanFile = ".masterflow/builds.hcl"
+
+func main() {
+	fsys := os.DirFS(".")
+	lang, name, ok := languages.Detect(fsys)
+	if !ok {
+		lang, ok = languages.Get("go")
+		name = "go"
+		if !ok {
+			log.Fatal("no language detected and no fallback registered")
+		}
+	}
+	cfg := lang.Config()
+
+	if name == "go" {
+		if _, err := os.Stat(buildPlanFile); err == nil {
+			plan, err := build.Load(buildPlanFile, nil)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if _, ok := plan.Build("default"); ok {
+				if err := golang.ApplyBuildPlan(cfg, plan, "default"); err != nil {
+					log.Fatal(err)
+				}
+			}
+		}
+	}
+
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "build-custom-linter":
+			if name != "go" {
+				log.Fatalf("build-custom-linter is only supported for the go language template, detected %q", name)
+			}
+			dir := "."
+			if len(os.Args) > 2 {
+				dir = os.Args[2]
+			}
+			if err := golang.BuildCustomLinter(cfg.Linting.CustomBinary, dir); err != nil {
+				log.Fatal(err)
+			}
+			return
+		case "k8s-test":
+			if name != "go" {
+				log.Fatalf("k8s-test is only supported for the go language template, detected %q", name)
+			}
+			if err := golang.RunKubernetesTests(cfg.Testing.Kubernetes); err != nil {
+				log.Fatal(err)
+			}
+			return
+		case "release-build":
+			if name != "go" {
+				log.Fatalf("release-build is only supported for the go language template, detected %q", name)
+			}
+			if err := golang.RunReleaseBuild(cfg.Deployment); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+
+	jsonData, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(jsonData))
+}
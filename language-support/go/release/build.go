@@ -0,0 +1,176 @@
+package release
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+)
+
+// BuildOptions configures BuildAll.
+type BuildOptions struct {
+	BinaryName string
+	Main       string
+	OutDir     string
+	Flags      []string
+	// Sign, when non-empty, is the cosign key reference BuildAll passes to
+	// `cosign sign-blob --key` for every artifact it produces.
+	Sign string
+}
+
+// buildResult records one target's output path for the checksum pass.
+type buildResult struct {
+	path string
+	err  error
+}
+
+// BuildAll builds opts.Main for every target in the matrix, in parallel
+// bounded by GOMAXPROCS, writing each artifact under
+// opts.OutDir/<target.ID()>/, named opts.BinaryName rendered through the
+// target's OutputTemplate. It then writes a checksums.txt (SHA256) in
+// opts.OutDir and, if opts.Sign is set, a cosign signature <artifact>.sig
+// for each one.
+func BuildAll(ctx context.Context, targets []TargetSpec, opts BuildOptions) error {
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	results := make([]buildResult, len(targets))
+
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target TargetSpec) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			path, err := buildOne(ctx, target, opts)
+			results[i] = buildResult{path: path, err: err}
+		}(i, target)
+	}
+	wg.Wait()
+
+	var paths []string
+	for i, r := range results {
+		if r.err != nil {
+			return fmt.Errorf("release: building %s: %w", targets[i].ID(), r.err)
+		}
+		paths = append(paths, r.path)
+	}
+
+	if err := writeChecksums(opts.OutDir, paths); err != nil {
+		return err
+	}
+
+	if opts.Sign != "" {
+		for _, p := range paths {
+			if err := signArtifact(ctx, p, opts.Sign); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func buildOne(ctx context.Context, target TargetSpec, opts BuildOptions) (string, error) {
+	outDir := filepath.Join(opts.OutDir, target.ID())
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return "", fmt.Errorf("creating out dir: %w", err)
+	}
+	outName, err := target.outputName(opts.BinaryName)
+	if err != nil {
+		return "", err
+	}
+	outPath := filepath.Join(outDir, outName)
+
+	args := []string{"build", "-trimpath", "-a", "-o", outPath}
+	for _, ld := range target.LDFlags {
+		args = append(args, "-ldflags", ld)
+	}
+	if len(target.Tags) > 0 {
+		args = append(args, "-tags", joinComma(target.Tags))
+	}
+	args = append(args, opts.Flags...)
+	args = append(args, opts.Main)
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Env = append(os.Environ(),
+		"GOOS="+target.GOOS,
+		"GOARCH="+target.GOARCH,
+		"CGO_ENABLED="+boolEnv(target.CGOEnabled),
+	)
+	if target.GOARM != "" {
+		cmd.Env = append(cmd.Env, "GOARM="+target.GOARM)
+	}
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("go build: %w: %s", err, out)
+	}
+	return outPath, nil
+}
+
+func writeChecksums(outDir string, paths []string) error {
+	f, err := os.Create(filepath.Join(outDir, "checksums.txt"))
+	if err != nil {
+		return fmt.Errorf("release: creating checksums.txt: %w", err)
+	}
+	defer f.Close()
+
+	for _, p := range paths {
+		sum, err := sha256File(p)
+		if err != nil {
+			return fmt.Errorf("release: checksumming %s: %w", p, err)
+		}
+		rel, err := filepath.Rel(outDir, p)
+		if err != nil {
+			rel = p
+		}
+		if _, err := fmt.Fprintf(f, "%s  %s\n", sum, rel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func signArtifact(ctx context.Context, path, key string) error {
+	cmd := exec.CommandContext(ctx, "cosign", "sign-blob", "--key", key, "--yes", "--output-signature", path+".sig", path)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("release: cosign sign-blob %s: %w: %s", path, err, out)
+	}
+	return nil
+}
+
+func joinComma(s []string) string {
+	out := ""
+	for i, v := range s {
+		if i > 0 {
+			out += ","
+		}
+		out += v
+	}
+	return out
+}
+
+func boolEnv(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
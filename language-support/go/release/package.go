@@ -0,0 +1,204 @@
+package release
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// PackageAll produces every archive in pkgs from the binaries BuildAll
+// already wrote under outDir, using each PackageSpec's InputMapping to
+// decide which target's binary goes where inside the archive. targets must
+// be the same matrix passed to BuildAll, so a target's OutputTemplate
+// resolves to the same file name BuildAll wrote.
+func PackageAll(ctx context.Context, outDir string, targets []TargetSpec, pkgs []PackageSpec, binaryName string) error {
+	byID := make(map[string]TargetSpec, len(targets))
+	for _, t := range targets {
+		byID[t.ID()] = t
+	}
+
+	for _, pkg := range pkgs {
+		if err := packageOne(ctx, outDir, byID, pkg, binaryName); err != nil {
+			return fmt.Errorf("release: packaging %s: %w", pkg.Format, err)
+		}
+	}
+	return nil
+}
+
+func packageOne(ctx context.Context, outDir string, byID map[string]TargetSpec, pkg PackageSpec, binaryName string) error {
+	switch pkg.Format {
+	case "tar_gz":
+		return packageTarGz(outDir, byID, pkg, binaryName)
+	case "zip":
+		return packageZip(outDir, byID, pkg, binaryName)
+	case "deb", "rpm":
+		return packageFPM(ctx, outDir, byID, pkg, binaryName)
+	default:
+		return fmt.Errorf("unknown package format %q", pkg.Format)
+	}
+}
+
+// srcName resolves the file BuildAll actually wrote for targetID: binaryName
+// rendered through that target's OutputTemplate, if it has a known target.
+func srcName(byID map[string]TargetSpec, targetID, binaryName string) (string, error) {
+	target, ok := byID[targetID]
+	if !ok {
+		return binaryName, nil
+	}
+	return target.outputName(binaryName)
+}
+
+func packageTarGz(outDir string, byID map[string]TargetSpec, pkg PackageSpec, binaryName string) error {
+	for targetID, archivePath := range pkg.InputMapping {
+		name, err := srcName(byID, targetID, binaryName)
+		if err != nil {
+			return err
+		}
+		srcPath := filepath.Join(outDir, targetID, name)
+		dstPath := filepath.Join(outDir, targetID+".tar.gz")
+		if err := writeTarGz(dstPath, srcPath, archivePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTarGz writes a single-entry .tar.gz archive, closing every writer
+// (and checking its error) before returning so a short write surfaced only
+// by Close doesn't produce a silently truncated archive.
+func writeTarGz(dstPath, srcPath, archivePath string) (err error) {
+	f, ferr := os.Create(dstPath)
+	if ferr != nil {
+		return ferr
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	gw := gzip.NewWriter(f)
+	defer func() {
+		if cerr := gw.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	tw := tar.NewWriter(gw)
+	defer func() {
+		if cerr := tw.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	return addToTar(tw, srcPath, archivePath)
+}
+
+func addToTar(tw *tar.Writer, srcPath, archivePath string) error {
+	info, err := os.Stat(srcPath)
+	if err != nil {
+		return err
+	}
+	hdr, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	hdr.Name = archivePath
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+func packageZip(outDir string, byID map[string]TargetSpec, pkg PackageSpec, binaryName string) error {
+	for targetID, archivePath := range pkg.InputMapping {
+		name, err := srcName(byID, targetID, binaryName)
+		if err != nil {
+			return err
+		}
+		srcPath := filepath.Join(outDir, targetID, name)
+		dstPath := filepath.Join(outDir, targetID+".zip")
+		if err := writeZip(dstPath, srcPath, archivePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeZip writes a single-entry .zip archive, closing every writer (and
+// checking its error) before returning so a short write surfaced only by
+// Close doesn't produce a silently truncated archive.
+func writeZip(dstPath, srcPath, archivePath string) (err error) {
+	f, ferr := os.Create(dstPath)
+	if ferr != nil {
+		return ferr
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	zw := zip.NewWriter(f)
+	defer func() {
+		if cerr := zw.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	return addToZip(zw, srcPath, archivePath)
+}
+
+func addToZip(zw *zip.Writer, srcPath, archivePath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	w, err := zw.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, src)
+	return err
+}
+
+// packageFPM shells out to fpm (https://fpm.readthedocs.io), the standard
+// tool for turning a directory of files into a .deb or .rpm, so this
+// package does not have to reimplement either archive format's control
+// metadata.
+func packageFPM(ctx context.Context, outDir string, byID map[string]TargetSpec, pkg PackageSpec, binaryName string) error {
+	for targetID, archivePath := range pkg.InputMapping {
+		name, err := srcName(byID, targetID, binaryName)
+		if err != nil {
+			return err
+		}
+		srcPath := filepath.Join(outDir, targetID, name)
+		dstPath := filepath.Join(outDir, targetID+"."+pkg.Format)
+
+		cmd := exec.CommandContext(ctx, "fpm",
+			"-s", "dir",
+			"-t", pkg.Format,
+			"-p", dstPath,
+			srcPath+"="+archivePath,
+		)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("fpm %s: %w: %s", targetID, err, out)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package release
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteChecksumsAndSha256File(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app")
+	if err := os.WriteFile(path, []byte("binary contents"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	sum, err := sha256File(path)
+	if err != nil {
+		t.Fatalf("sha256File: %v", err)
+	}
+	if len(sum) != 64 {
+		t.Fatalf("sha256File() = %q, want a 64-char hex digest", sum)
+	}
+
+	if err := writeChecksums(dir, []string{path}); err != nil {
+		t.Fatalf("writeChecksums: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "checksums.txt"))
+	if err != nil {
+		t.Fatalf("ReadFile checksums.txt: %v", err)
+	}
+	want := sum + "  app\n"
+	if string(data) != want {
+		t.Errorf("checksums.txt = %q, want %q", data, want)
+	}
+}
+
+func TestTargetSpecOutputName(t *testing.T) {
+	tests := []struct {
+		name string
+		t    TargetSpec
+		want string
+	}{
+		{
+			name: "no template",
+			t:    TargetSpec{GOOS: "linux", GOARCH: "amd64"},
+			want: "app",
+		},
+		{
+			name: "templated",
+			t:    TargetSpec{GOOS: "linux", GOARCH: "amd64", OutputTemplate: "{{.Name}}_{{.GOOS}}_{{.GOARCH}}"},
+			want: "app_linux_amd64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.t.outputName("app")
+			if err != nil {
+				t.Fatalf("outputName(): %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("outputName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPackageTarGzRoundTrip(t *testing.T) {
+	outDir := t.TempDir()
+	targetDir := filepath.Join(outDir, "linux_amd64")
+	if err := os.MkdirAll(targetDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(targetDir, "app"), []byte("hello"), 0o755); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	byID := map[string]TargetSpec{"linux_amd64": {GOOS: "linux", GOARCH: "amd64"}}
+	pkg := PackageSpec{Format: "tar_gz", InputMapping: map[string]string{"linux_amd64": "bin/app"}}
+	if err := packageTarGz(outDir, byID, pkg, "app"); err != nil {
+		t.Fatalf("packageTarGz: %v", err)
+	}
+
+	f, err := os.Open(filepath.Join(outDir, "linux_amd64.tar.gz"))
+	if err != nil {
+		t.Fatalf("Open archive: %v", err)
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	tr := tar.NewReader(gr)
+	hdr, err := tr.Next()
+	if err != nil {
+		t.Fatalf("tar Next: %v", err)
+	}
+	if hdr.Name != "bin/app" {
+		t.Errorf("archive entry name = %q, want %q", hdr.Name, "bin/app")
+	}
+	contents, err := io.ReadAll(tr)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(contents) != "hello" {
+		t.Errorf("archive entry contents = %q, want %q", contents, "hello")
+	}
+}
+
+func TestPackageZipRoundTrip(t *testing.T) {
+	outDir := t.TempDir()
+	targetDir := filepath.Join(outDir, "windows_amd64")
+	if err := os.MkdirAll(targetDir, 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(targetDir, "app.exe"), []byte("hello"), 0o755); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	byID := map[string]TargetSpec{"windows_amd64": {GOOS: "windows", GOARCH: "amd64", OutputTemplate: "app.exe"}}
+	pkg := PackageSpec{Format: "zip", InputMapping: map[string]string{"windows_amd64": "app.exe"}}
+	if err := packageZip(outDir, byID, pkg, "app"); err != nil {
+		t.Fatalf("packageZip: %v", err)
+	}
+
+	zr, err := zip.OpenReader(filepath.Join(outDir, "windows_amd64.zip"))
+	if err != nil {
+		t.Fatalf("zip.OpenReader: %v", err)
+	}
+	defer zr.Close()
+
+	if len(zr.File) != 1 || zr.File[0].Name != "app.exe" {
+		t.Fatalf("zip entries = %v, want single entry named app.exe", zr.File)
+	}
+	rc, err := zr.File[0].Open()
+	if err != nil {
+		t.Fatalf("opening zip entry: %v", err)
+	}
+	defer rc.Close()
+	contents, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(contents) != "hello" {
+		t.Errorf("zip entry contents = %q, want %q", contents, "hello")
+	}
+}
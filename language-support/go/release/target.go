@@ -0,0 +1,68 @@
+/*
+Package release builds the cross-compilation matrix and archive packaging
+a DeploymentConfig.CrossCompile / DeploymentConfig.Package describe, so
+MASTER-WORKFLOW templates can emit a release pipeline instead of a single
+`go build`.
+*/
+package release
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// TargetSpec is one entry in DeploymentConfig.CrossCompile: a single
+// GOOS/GOARCH combination to build the binary for.
+type TargetSpec struct {
+	GOOS           string   `json:"goos"`
+	GOARCH         string   `json:"goarch"`
+	GOARM          string   `json:"goarm,omitempty"`
+	CGOEnabled     bool     `json:"cgoEnabled"`
+	Tags           []string `json:"tags,omitempty"`
+	LDFlags        []string `json:"ldflags,omitempty"`
+	OutputTemplate string   `json:"outputTemplate"`
+}
+
+// ID returns the target's canonical identifier, e.g. "linux_amd64", used
+// both as the output directory name and as a PackageSpec.InputMapping key.
+func (t TargetSpec) ID() string {
+	if t.GOARM != "" {
+		return t.GOOS + "_" + t.GOARCH + "v" + t.GOARM
+	}
+	return t.GOOS + "_" + t.GOARCH
+}
+
+// outputName renders OutputTemplate (fields .Name, .GOOS, .GOARCH) to the
+// binary's file name for this target, falling back to binaryName unchanged
+// when OutputTemplate is empty.
+func (t TargetSpec) outputName(binaryName string) (string, error) {
+	if t.OutputTemplate == "" {
+		return binaryName, nil
+	}
+
+	tmpl, err := template.New("outputTemplate").Parse(t.OutputTemplate)
+	if err != nil {
+		return "", fmt.Errorf("release: parsing output template %q: %w", t.OutputTemplate, err)
+	}
+
+	var buf strings.Builder
+	data := struct {
+		Name   string
+		GOOS   string
+		GOARCH string
+	}{Name: binaryName, GOOS: t.GOOS, GOARCH: t.GOARCH}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("release: rendering output template %q: %w", t.OutputTemplate, err)
+	}
+	return buf.String(), nil
+}
+
+// PackageSpec is one entry in DeploymentConfig.Package: an archive format
+// to produce from one or more built targets.
+type PackageSpec struct {
+	Format string `json:"format"` // "tar_gz", "zip", "deb", or "rpm"
+	// InputMapping maps a TargetSpec.ID() to the path the binary should
+	// occupy inside the archive.
+	InputMapping map[string]string `json:"inputMapping"`
+}
@@ -0,0 +1,108 @@
+/*
+Package test implements the MASTER-WORKFLOW Kubernetes integration test
+harness: declarative YAML test cases applied against a real or
+KIND-provisioned cluster. Each case is a directory of numbered steps, e.g.
+
+	00-apply.yaml
+	00-assert.yaml
+	00-delete.yaml
+	01-apply.yaml
+	01-assert.yaml
+
+Steps run in ascending numeric order; a missing assert or delete file for a
+given number simply skips that half of the step.
+*/
+package test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Step is one numbered apply/assert/delete triple within a Case.
+type Step struct {
+	Index      int
+	ApplyPath  string
+	AssertPath string
+	DeletePath string
+}
+
+// Case owns an ordered list of Steps loaded from a directory.
+type Case struct {
+	Name  string
+	Dir   string
+	Steps []Step
+}
+
+var stepFileRE = regexp.MustCompile(`^(\d+)-(apply|assert|delete)\.ya?ml$`)
+
+// LoadCase scans dir for NN-apply.yaml / NN-assert.yaml / NN-delete.yaml
+// files and assembles them into an ordered Case.
+func LoadCase(dir string) (*Case, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("test: reading case dir %s: %w", dir, err)
+	}
+
+	byIndex := map[int]*Step{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		m := stepFileRE.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+		step, ok := byIndex[n]
+		if !ok {
+			step = &Step{Index: n}
+			byIndex[n] = step
+		}
+		path := filepath.Join(dir, e.Name())
+		switch m[2] {
+		case "apply":
+			step.ApplyPath = path
+		case "assert":
+			step.AssertPath = path
+		case "delete":
+			step.DeletePath = path
+		}
+	}
+
+	steps := make([]Step, 0, len(byIndex))
+	for _, s := range byIndex {
+		steps = append(steps, *s)
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].Index < steps[j].Index })
+
+	return &Case{Name: filepath.Base(dir), Dir: dir, Steps: steps}, nil
+}
+
+// LoadCases loads every immediate subdirectory of casesDir as a Case.
+func LoadCases(casesDir string) ([]*Case, error) {
+	entries, err := os.ReadDir(casesDir)
+	if err != nil {
+		return nil, fmt.Errorf("test: reading cases dir %s: %w", casesDir, err)
+	}
+
+	var cases []*Case
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		c, err := LoadCase(filepath.Join(casesDir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, c)
+	}
+	return cases, nil
+}
@@ -0,0 +1,23 @@
+package test
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// JSONDecode is the built-in YAMLDecode: since every JSON document is also
+// valid YAML, it handles assert/apply files written in JSON-compatible
+// style using only the standard library. Cases using full YAML syntax
+// (anchors, comments, unquoted multiline strings) need a decoder backed by
+// gopkg.in/yaml.v3 instead.
+func JSONDecode(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
@@ -0,0 +1,84 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// Harness manages the lifecycle of the cluster a Case runs against: either
+// an existing cluster reached via Kubeconfig, or a disposable one spun up
+// with `kind create cluster`.
+type Harness struct {
+	Kubeconfig  string
+	ClusterName string
+	managedKind bool
+}
+
+// NewHarness returns a Harness. If kubeconfig is empty and useKind is true,
+// Start provisions a KIND cluster named clusterName and Stop tears it down;
+// otherwise Start reuses the kubeconfig as-is and Stop is a no-op.
+func NewHarness(kubeconfig string, useKind bool, clusterName string) *Harness {
+	h := &Harness{Kubeconfig: kubeconfig, ClusterName: clusterName}
+	if kubeconfig == "" && useKind {
+		h.managedKind = true
+	}
+	return h
+}
+
+// Start prepares the cluster the Harness will run cases against.
+func (h *Harness) Start(ctx context.Context) error {
+	if !h.managedKind {
+		if h.Kubeconfig == "" {
+			return fmt.Errorf("test: harness has no kubeconfig and KIND is disabled")
+		}
+		if _, err := os.Stat(h.Kubeconfig); err != nil {
+			return fmt.Errorf("test: kubeconfig %s: %w", h.Kubeconfig, err)
+		}
+		return nil
+	}
+
+	cmd := exec.CommandContext(ctx, "kind", "create", "cluster", "--name", h.ClusterName)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("test: kind create cluster: %w", err)
+	}
+
+	kubeconfig, err := os.CreateTemp("", h.ClusterName+"-kubeconfig-*.yaml")
+	if err != nil {
+		return fmt.Errorf("test: creating temp kubeconfig: %w", err)
+	}
+	kubeconfig.Close()
+
+	get := exec.CommandContext(ctx, "kind", "get", "kubeconfig", "--name", h.ClusterName)
+	out, err := get.Output()
+	if err != nil {
+		return fmt.Errorf("test: kind get kubeconfig: %w", err)
+	}
+	if err := os.WriteFile(kubeconfig.Name(), out, 0o600); err != nil {
+		return fmt.Errorf("test: writing kubeconfig: %w", err)
+	}
+	h.Kubeconfig = kubeconfig.Name()
+	return nil
+}
+
+// Stop tears down the cluster if the Harness provisioned it itself.
+func (h *Harness) Stop(ctx context.Context) error {
+	if !h.managedKind {
+		return nil
+	}
+	cmd := exec.CommandContext(ctx, "kind", "delete", "cluster", "--name", h.ClusterName)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("test: kind delete cluster: %w", err)
+	}
+	return os.Remove(h.Kubeconfig)
+}
+
+func (h *Harness) kubectl(ctx context.Context, args ...string) *exec.Cmd {
+	full := append([]string{"--kubeconfig", h.Kubeconfig}, args...)
+	return exec.CommandContext(ctx, "kubectl", full...)
+}
@@ -0,0 +1,68 @@
+package test
+
+// YAMLDecode parses a YAML file into a generic document tree. This package
+// stays dependency-free, so callers inject a decoder backed by
+// gopkg.in/yaml.v3 (or any other YAML implementation) when constructing a
+// Runner.
+type YAMLDecode func(path string) (map[string]any, error)
+
+// matchFields reports whether every field in expected is present in actual
+// with an equal value, recursing into nested maps. This is the "arbitrary
+// field matcher" an NN-assert.yaml step is checked against: expected is a
+// partial object, actual is the live resource read back from the cluster,
+// and only the fields named in expected need to match (e.g. CRD readiness
+// is just asserting status.conditions contains a type: Established entry).
+func matchFields(expected, actual map[string]any) bool {
+	for k, ev := range expected {
+		av, ok := actual[k]
+		if !ok {
+			return false
+		}
+		switch evt := ev.(type) {
+		case map[string]any:
+			avt, ok := av.(map[string]any)
+			if !ok || !matchFields(evt, avt) {
+				return false
+			}
+		case []any:
+			avt, ok := av.([]any)
+			if !ok || !matchSlice(evt, avt) {
+				return false
+			}
+		default:
+			if av != ev {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchSlice reports whether every element of expected has at least one
+// matching element in actual, which is what lets an assert file check for
+// one entry in a list (e.g. a single matching status condition) without
+// enumerating the whole list.
+func matchSlice(expected, actual []any) bool {
+	for _, ee := range expected {
+		found := false
+		for _, ae := range actual {
+			em, eok := ee.(map[string]any)
+			am, aok := ae.(map[string]any)
+			if eok && aok {
+				if matchFields(em, am) {
+					found = true
+					break
+				}
+				continue
+			}
+			if ee == ae {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package test
+
+import "testing"
+
+func TestMatchFieldsPartialMatch(t *testing.T) {
+	expected := map[string]any{
+		"status": map[string]any{
+			"phase": "Running",
+		},
+	}
+	actual := map[string]any{
+		"status": map[string]any{
+			"phase":      "Running",
+			"podIP":      "10.0.0.1",
+			"conditions": []any{},
+		},
+		"metadata": map[string]any{"name": "pod-1"},
+	}
+
+	if !matchFields(expected, actual) {
+		t.Fatal("matchFields() = false, want true for matching partial fields")
+	}
+}
+
+func TestMatchFieldsMismatch(t *testing.T) {
+	expected := map[string]any{"status": map[string]any{"phase": "Running"}}
+	actual := map[string]any{"status": map[string]any{"phase": "Pending"}}
+
+	if matchFields(expected, actual) {
+		t.Fatal("matchFields() = true, want false for differing values")
+	}
+}
+
+func TestMatchFieldsMissingKey(t *testing.T) {
+	expected := map[string]any{"status": map[string]any{"phase": "Running"}}
+	actual := map[string]any{"metadata": map[string]any{"name": "pod-1"}}
+
+	if matchFields(expected, actual) {
+		t.Fatal("matchFields() = true, want false when expected key is absent")
+	}
+}
+
+func TestMatchSliceFindsOneMatchingEntry(t *testing.T) {
+	expected := []any{
+		map[string]any{"type": "Established"},
+	}
+	actual := []any{
+		map[string]any{"type": "NamesAccepted", "status": "True"},
+		map[string]any{"type": "Established", "status": "True"},
+	}
+
+	if !matchSlice(expected, actual) {
+		t.Fatal("matchSlice() = false, want true: actual contains a matching condition")
+	}
+}
+
+func TestMatchSliceNoMatch(t *testing.T) {
+	expected := []any{map[string]any{"type": "Established"}}
+	actual := []any{map[string]any{"type": "NamesAccepted"}}
+
+	if matchSlice(expected, actual) {
+		t.Fatal("matchSlice() = true, want false: no element satisfies expected")
+	}
+}
+
+func TestMatchFieldsNestedSlice(t *testing.T) {
+	expected := map[string]any{
+		"status": map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Established"},
+			},
+		},
+	}
+	actual := map[string]any{
+		"status": map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Established", "status": "True"},
+			},
+		},
+	}
+
+	if !matchFields(expected, actual) {
+		t.Fatal("matchFields() = false, want true: nested slice condition should match")
+	}
+}
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Runner executes Cases against a Harness, polling each step's assertion
+// until it matches or the step times out.
+type Runner struct {
+	Harness *Harness
+	Decode  YAMLDecode
+	Timeout time.Duration
+	Poll    time.Duration
+}
+
+// NewRunner returns a Runner. decode parses the YAML apply/assert/delete
+// files this package itself never needs to fully understand except when
+// checking an assertion; timeout bounds how long a single assert step polls
+// before failing, defaulting to 2 minutes.
+func NewRunner(h *Harness, decode YAMLDecode, timeout time.Duration) *Runner {
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+	return &Runner{Harness: h, Decode: decode, Timeout: timeout, Poll: 2 * time.Second}
+}
+
+// Run applies, asserts, and deletes every step of c in order, stopping at
+// the first step that fails.
+func (r *Runner) Run(ctx context.Context, c *Case) error {
+	for _, step := range c.Steps {
+		if step.ApplyPath != "" {
+			if out, err := r.Harness.kubectl(ctx, "apply", "-f", step.ApplyPath).CombinedOutput(); err != nil {
+				return fmt.Errorf("test: case %s step %d apply: %w: %s", c.Name, step.Index, err, out)
+			}
+		}
+		if step.AssertPath != "" {
+			if err := r.waitForAssert(ctx, c, step); err != nil {
+				return err
+			}
+		}
+		if step.DeletePath != "" {
+			if out, err := r.Harness.kubectl(ctx, "delete", "-f", step.DeletePath).CombinedOutput(); err != nil {
+				return fmt.Errorf("test: case %s step %d delete: %w: %s", c.Name, step.Index, err, out)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Runner) waitForAssert(ctx context.Context, c *Case, step Step) error {
+	expected, err := r.Decode(step.AssertPath)
+	if err != nil {
+		return fmt.Errorf("test: case %s step %d: decoding assert file: %w", c.Name, step.Index, err)
+	}
+
+	kind, name, namespace, err := resourceRef(expected)
+	if err != nil {
+		return fmt.Errorf("test: case %s step %d: %w", c.Name, step.Index, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(r.Poll)
+	defer ticker.Stop()
+
+	for {
+		args := []string{"get", kind, name, "-o", "json"}
+		if namespace != "" {
+			args = append(args, "-n", namespace)
+		}
+		if out, err := r.Harness.kubectl(ctx, args...).Output(); err == nil {
+			var actual map[string]any
+			if jsonErr := json.Unmarshal(out, &actual); jsonErr == nil && matchFields(expected, actual) {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("test: case %s step %d: timed out waiting for %s/%s to match", c.Name, step.Index, kind, name)
+		case <-ticker.C:
+		}
+	}
+}
+
+func resourceRef(doc map[string]any) (kind, name, namespace string, err error) {
+	kind, _ = doc["kind"].(string)
+	if kind == "" {
+		return "", "", "", fmt.Errorf("assert file missing kind")
+	}
+	metadata, _ := doc["metadata"].(map[string]any)
+	name, _ = metadata["name"].(string)
+	if name == "" {
+		return "", "", "", fmt.Errorf("assert file missing metadata.name")
+	}
+	namespace, _ = metadata["namespace"].(string)
+	return kind, name, namespace, nil
+}